@@ -0,0 +1,158 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func testBlockWords() [ECCBlockWords]uint64 {
+	var words [ECCBlockWords]uint64
+	for j := range words {
+		words[j] = testPattern ^ uint64(j)
+	}
+	return words
+}
+
+// flipPhysicalBit flips one bit of an ECCBlock's interleaved physical
+// storage, addressed as plane p/ECCBlockWords, word p%ECCBlockWords -- the
+// same addressing a real burst across the physical bit stream would use.
+func flipPhysicalBit(b *ECCBlock, p int) {
+	plane, word := p/ECCBlockWords, p%ECCBlockWords
+	b.planes[plane] ^= 1 << uint(word)
+}
+
+func TestECCBlockRoundTrip(t *testing.T) {
+	words := testBlockWords()
+	block := WriteBlock(words)
+
+	results, err := ReadBlock(block)
+	if err != nil {
+		t.Fatalf("ReadBlock: %v", err)
+	}
+	for j, r := range results {
+		if r.Data != words[j] {
+			t.Fatalf("word %d: got %#016x, want %#016x", j, r.Data, words[j])
+		}
+		if r.Status != StatusOK {
+			t.Fatalf("word %d: status = %s, want OK", j, r.Status)
+		}
+	}
+}
+
+// TestECCBlockCorrectsPhysicalBurst confirms the whole point of
+// bit-interleaving: a contiguous physical burst up to ECCBlockWords bits
+// wide lands on at most one bit per logical word, so every word is still
+// correctable even though the per-word scheme only tolerates one bit flip
+// each.
+func TestECCBlockCorrectsPhysicalBurst(t *testing.T) {
+	starts := []int{0, 3, eccBlockWordBits*ECCBlockWords - ECCBlockWords}
+	for _, start := range starts {
+		words := testBlockWords()
+		block := WriteBlock(words)
+
+		for p := start; p < start+ECCBlockWords; p++ {
+			flipPhysicalBit(block, p)
+		}
+
+		results, err := ReadBlock(block)
+		if err != nil {
+			t.Fatalf("burst at %d: ReadBlock: %v", start, err)
+		}
+		for j, r := range results {
+			if r.Data != words[j] {
+				t.Fatalf("burst at %d: word %d: got %#016x, want %#016x", start, j, r.Data, words[j])
+			}
+		}
+	}
+}
+
+// TestECCBlockReadBlockReportsUncorrectableWord forces a two-bit error
+// within a single word's own representation (not a physical burst) and
+// checks ReadBlock still decodes the other words while reporting the bad
+// one's index.
+func TestECCBlockReadBlockReportsUncorrectableWord(t *testing.T) {
+	words := testBlockWords()
+	block := WriteBlock(words)
+
+	const badWord = 3
+	flipPhysicalBit(block, 0*ECCBlockWords+badWord)
+	flipPhysicalBit(block, 1*ECCBlockWords+badWord)
+
+	results, err := ReadBlock(block)
+
+	var blockErr *ErrUncorrectableBlock
+	if !errors.As(err, &blockErr) {
+		t.Fatalf("err = %v, want *ErrUncorrectableBlock", err)
+	}
+	if len(blockErr.Words) != 1 || blockErr.Words[0] != badWord {
+		t.Fatalf("Words = %v, want [%d]", blockErr.Words, badWord)
+	}
+	for j, r := range results {
+		if j == badWord {
+			continue
+		}
+		if r.Data != words[j] {
+			t.Fatalf("word %d: got %#016x, want %#016x", j, r.Data, words[j])
+		}
+	}
+}
+
+func TestScrubCorrectsInPlace(t *testing.T) {
+	words := testBlockWords()
+	block := WriteBlock(words)
+	flipPhysicalBit(block, 5*ECCBlockWords+2)
+
+	if err := Scrub(block); err != nil {
+		t.Fatalf("Scrub: %v", err)
+	}
+
+	results, err := ReadBlock(block)
+	if err != nil {
+		t.Fatalf("ReadBlock after Scrub: %v", err)
+	}
+	for j, r := range results {
+		if r.Data != words[j] {
+			t.Fatalf("word %d: got %#016x, want %#016x (Scrub should have rewritten it clean)", j, r.Data, words[j])
+		}
+	}
+}
+
+func TestScrubberCorrectsAndReportsStats(t *testing.T) {
+	mem := make([]*ECCWord, 4)
+	for i := range mem {
+		mem[i] = Write(testPattern^uint64(i), hammingSECDED64{})
+	}
+	flipBit(mem[1], 10) // single-bit data error
+	flipBit(mem[2], 66) // single-bit ECC error
+
+	var uncorrectableCalls int
+	s := NewScrubber(mem, time.Millisecond)
+	s.OnUncorrectable = func(index int, word *ECCWord) { uncorrectableCalls++ }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s.Start(ctx)
+
+	deadline := time.After(time.Second)
+	for {
+		stats := s.Stats()
+		if stats.DataCorrections >= 1 && stats.ECCCorrections >= 1 && stats.WordsScrubbed >= uint64(len(mem)) {
+			break
+		}
+		select {
+		case <-deadline:
+			t.Fatalf("timed out waiting for scrub stats, last seen: %+v", stats)
+		case <-time.After(time.Millisecond):
+		}
+	}
+	s.Stop()
+
+	if mem[1].Data != testPattern^1 {
+		t.Fatalf("word 1 not corrected in place: %#016x", mem[1].Data)
+	}
+	if uncorrectableCalls != 0 {
+		t.Fatalf("unexpected uncorrectable calls: %d", uncorrectableCalls)
+	}
+}
@@ -0,0 +1,21 @@
+package main
+
+import "testing"
+
+// TestDAECLayoutReservesECCSyndromes locks in the invariant newDAECLayout
+// depends on: every power-of-two syndrome (1<<j for j < codeBits) is a lone
+// ECC-bit flip and must never also be handed out as a data bit's column.
+// Previously the column-assignment loop didn't check against these values,
+// so a single flipped Hamming parity bit could collide with a data column
+// and get miscorrected as a (nonexistent) data error instead of reported as
+// StatusCorrectedECC -- silent data corruption on one of the most common
+// faults. This pins down the layout precondition a full Decode-level
+// exhaustive test would otherwise have to rediscover.
+func TestDAECLayoutReservesECCSyndromes(t *testing.T) {
+	for j := 0; j < daecLayout64.codeBits; j++ {
+		reserved := uint16(1) << uint(j)
+		if _, ok := daecLayout64.dataBitForColumn(reserved); ok {
+			t.Fatalf("syndrome %#x (lone ECC bit %d) is also assigned as a data column", reserved, j)
+		}
+	}
+}
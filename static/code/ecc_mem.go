@@ -1,22 +1,52 @@
 package main
 
 import (
+	"context"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
 	"math/bits"
+	"sync"
+	"time"
 )
 
-// ErrUncorrectable is returned when a multi-bit error is detected that cannot
-// be corrected by the SECDED algorithm.
+// ErrUncorrectable is the sentinel multi-bit errors match via errors.Is.
+// Read and the ECCScheme implementations return an *UncorrectableError
+// instead of this sentinel directly, so callers that want the syndrome
+// details can errors.As for it.
 var ErrUncorrectable = errors.New("ecc: uncorrectable multi-bit error")
 
+// UncorrectableError is returned when a multi-bit error is detected that
+// cannot be corrected by the active ECCScheme. It carries the raw syndrome
+// so observability layers can log or alert on it.
+type UncorrectableError struct {
+	Syndrome   uint8
+	ParityEven bool
+	Word       ECCWord
+}
+
+func (e *UncorrectableError) Error() string {
+	return fmt.Sprintf(
+		"ecc: uncorrectable multi-bit error (syndrome=%#02x parityEven=%v word=%s)",
+		e.Syndrome, e.ParityEven, e.Word,
+	)
+}
+
+// Is reports whether target is ErrUncorrectable, so existing callers using
+// errors.Is(err, ErrUncorrectable) keep working.
+func (e *UncorrectableError) Is(target error) bool {
+	return target == ErrUncorrectable
+}
+
 // ReadStatus indicates the outcome of a Read operation.
 type ReadStatus int
 
 const (
-	StatusOK            ReadStatus = iota // No errors detected
-	StatusCorrectedData                   // Single-bit data error was corrected
-	StatusCorrectedECC                    // Single-bit ECC metadata error was corrected
+	StatusOK                      ReadStatus = iota // No errors detected
+	StatusCorrectedData                             // Single-bit data error was corrected
+	StatusCorrectedECC                              // Single-bit ECC metadata error was corrected
+	StatusCorrectedAdjacentDouble                   // Adjacent double-bit burst was corrected (DAEC schemes only)
 )
 
 // String returns a human-readable description of the read status.
@@ -28,6 +58,8 @@ func (s ReadStatus) String() string {
 		return "Corrected single-bit data error"
 	case StatusCorrectedECC:
 		return "Corrected single-bit ECC error"
+	case StatusCorrectedAdjacentDouble:
+		return "Corrected adjacent double-bit burst"
 	default:
 		return "Unknown status"
 	}
@@ -37,90 +69,192 @@ func (s ReadStatus) String() string {
 type ReadResult struct {
 	Data   uint64
 	Status ReadStatus
+	Event  CorrectionEvent
 }
 
-// ECCWord models an ECC-Protected memory word as:
-//   - 64 bits of data
-//   - 8 bits of ECC metadata
+// CorrectionEvent describes which bit(s) a Read corrected, so callers
+// building observability layers (Prometheus counters, structured logs) can
+// attribute corrections to specific bit positions and watch for bits that
+// fail repeatedly -- a sign of a failing cell, not a one-off upset.
 //
-// In reality it would be stored as a 72 bit word (9 bytes)
+// BitPositions indexes into the scheme's own word layout: 0..DataBits()-1
+// are data bits, DataBits() and up are ECC bits. It's empty when Status is
+// StatusOK.
+type CorrectionEvent struct {
+	BitPositions []int
+}
+
+// SchemeID tags an ECCWord with the code strength/variant that produced its
+// ECC metadata, so Read knows which ECCScheme to decode it with.
+type SchemeID int
+
+const (
+	SchemeHammingSECDED64 SchemeID = iota
+	SchemeHammingSECDED32
+	SchemeHammingSECDED16
+	SchemeSECDEDDAEC64
+)
+
+// String returns the scheme's display name.
+func (id SchemeID) String() string {
+	scheme, ok := schemeByID[id]
+	if !ok {
+		return "unknown scheme"
+	}
+	return scheme.Name()
+}
+
+// ECCScheme is implemented by each supported code strength/variant. It knows
+// how to compute ECC metadata for a data word and how to decode a
+// (possibly corrupted) word back into data, detecting and, where the
+// variant supports it, correcting errors.
+type ECCScheme interface {
+	// ID returns the SchemeID used to tag ECCWords produced by this scheme.
+	ID() SchemeID
+
+	// Name returns a human-readable name for the scheme, e.g. "SECDED-64".
+	Name() string
+
+	// DataBits returns the number of data bits this scheme protects.
+	DataBits() int
+
+	// Encode computes the ECC metadata for the given data word. Only the
+	// low DataBits() bits of data are significant.
+	Encode(data uint64) uint16
+
+	// Decode checks data+ecc for errors and returns the corrected data
+	// word, the corrected ECC metadata, a status describing what (if
+	// anything) was fixed, and a CorrectionEvent recording which bit(s)
+	// were flipped. It returns an *UncorrectableError if the error can't
+	// be fixed by this scheme.
+	Decode(data uint64, ecc uint16) (correctedData uint64, correctedECC uint16, status ReadStatus, event CorrectionEvent, err error)
+}
+
+// schemeByID lets Read look up the ECCScheme that produced a given ECCWord
+// from its Scheme tag.
+var schemeByID = map[SchemeID]ECCScheme{
+	SchemeHammingSECDED64: hammingSECDED64{},
+	SchemeHammingSECDED32: hammingSECDED32{},
+	SchemeHammingSECDED16: hammingSECDED16{},
+	SchemeSECDEDDAEC64:    secdedDAEC64{},
+}
+
+// ECCWord models an ECC-protected memory word as:
+//   - up to 64 bits of data
+//   - a scheme tag identifying which ECCScheme encoded it
+//   - the ECC metadata produced by that scheme
+//
+// In reality it would be stored as a single contiguous word of
+// DataBits()+len(ECC bits) bits.
 type ECCWord struct {
-	Data uint64
-	ECC  uint8
+	Data   uint64
+	ECC    uint16
+	Scheme SchemeID
 }
 
 // String returns a human-readable representation of the ECCWord showing the
-// 64-bit data in hexadecimal and the 8-bit ECC metadata in binary.
+// scheme name, the data in hexadecimal, and the ECC metadata in binary.
 func (w ECCWord) String() string {
 	return fmt.Sprintf(
-		"ECCWord data=0x%016X ecc=%08b",
-		w.Data, w.ECC,
+		"ECCWord scheme=%s data=0x%016X ecc=%016b",
+		w.Scheme, w.Data, w.ECC,
 	)
 }
 
-// Write simulates a memory write
-func Write(data uint64) *ECCWord {
+// Write simulates a memory write, encoding data with the given ECCScheme.
+func Write(data uint64, scheme ECCScheme) *ECCWord {
 	return &ECCWord{
-		Data: data,
-		ECC:  computeECC(data),
+		Data:   data,
+		ECC:    scheme.Encode(data),
+		Scheme: scheme.ID(),
 	}
 }
 
-// Read simulates a memory read with error detection and correction.
+// Read simulates a memory read with error detection and correction, using
+// whichever ECCScheme the word was written with.
 // Returns a ReadResult on success (including corrected errors) or an error
 // if the data is unrecoverable.
 func Read(w *ECCWord) (ReadResult, error) {
 	assert(w != nil, "require ECCWord to read")
 
-	expectedECC := computeECC(w.Data)
-	syndrome := w.ECC ^ expectedECC
+	scheme, ok := schemeByID[w.Scheme]
+	assert(ok, "unknown ECC scheme tag")
+
+	data, ecc, status, event, err := scheme.Decode(w.Data, w.ECC)
+	if err != nil {
+		return ReadResult{}, err
+	}
+
+	w.Data = data
+	w.ECC = ecc
+	return ReadResult{Data: data, Status: status, Event: event}, nil
+}
+
+// --- HammingSECDED64: the original (72,64) SECDED code ---------------------
+
+// hammingSECDED64 implements the original single-word SECDED code: 64 bits
+// of data protected by 7 Hamming parity bits plus 1 overall parity bit.
+type hammingSECDED64 struct{}
+
+func (hammingSECDED64) ID() SchemeID  { return SchemeHammingSECDED64 }
+func (hammingSECDED64) Name() string  { return "SECDED-64" }
+func (hammingSECDED64) DataBits() int { return 64 }
+
+func (hammingSECDED64) Encode(data uint64) uint16 {
+	return uint16(computeECC64(data))
+}
+
+func (hammingSECDED64) Decode(data uint64, ecc uint16) (uint64, uint16, ReadStatus, CorrectionEvent, error) {
+	eccByte := uint8(ecc)
+
+	expectedECC := computeECC64(data)
+	syndrome := eccByte ^ expectedECC
 
 	hammingSyndrome := syndrome & 0x7F
 
-	// Check overall parity of the entire stored word (data + ECC)
-	totalOnes := bits.OnesCount64(w.Data) + bits.OnesCount8(w.ECC)
+	totalOnes := bits.OnesCount64(data) + bits.OnesCount8(eccByte)
 	parityError := totalOnes%2 != 0
 
-	// No error: hamming matches and parity is even
 	if hammingSyndrome == 0 && !parityError {
-		return ReadResult{Data: w.Data, Status: StatusOK}, nil
+		return data, uint16(eccByte), StatusOK, CorrectionEvent{}, nil
 	}
 
-	// Single-bit error: parity is odd and syndrome is non-zero
 	if parityError && hammingSyndrome != 0 {
-		syndrome := int(hammingSyndrome)
+		syndromeInt := int(hammingSyndrome)
 
-		// Power-of-2 syndromes indicate a Hamming parity bit error (not data)
-		if isPowerOfTwo(syndrome) {
-			w.ECC = computeECC(w.Data)
-			return ReadResult{Data: w.Data, Status: StatusCorrectedECC}, nil
+		if isPowerOfTwo(syndromeInt) {
+			eccBit := bits.TrailingZeros(uint(syndromeInt))
+			event := CorrectionEvent{BitPositions: []int{64 + eccBit}}
+			return data, uint16(computeECC64(data)), StatusCorrectedECC, event, nil
 		}
 
-		// Non-power-of-2 syndrome indicates a data bit error
-		if syndrome < len(hammingToData) {
-			dataBit := hammingToData[syndrome]
+		if syndromeInt < len(hammingToData) {
+			dataBit := hammingToData[syndromeInt]
 			if dataBit >= 0 && dataBit < 64 {
-				w.Data ^= 1 << dataBit
-				w.ECC = computeECC(w.Data)
-				return ReadResult{Data: w.Data, Status: StatusCorrectedData}, nil
+				data ^= 1 << dataBit
+				event := CorrectionEvent{BitPositions: []int{dataBit}}
+				return data, uint16(computeECC64(data)), StatusCorrectedData, event, nil
 			}
 		}
 	}
 
-	// Single-bit ECC error: parity is odd but syndrome is zero (overall parity bit error)
 	if parityError && hammingSyndrome == 0 {
-		w.ECC = computeECC(w.Data)
-		return ReadResult{Data: w.Data, Status: StatusCorrectedECC}, nil
+		event := CorrectionEvent{BitPositions: []int{71}} // overall parity bit
+		return data, uint16(computeECC64(data)), StatusCorrectedECC, event, nil
 	}
 
-	// Multi-bit error: parity is even but hamming is non-zero
-	return ReadResult{}, ErrUncorrectable
+	return 0, 0, 0, CorrectionEvent{}, &UncorrectableError{
+		Syndrome:   hammingSyndrome,
+		ParityEven: !parityError,
+		Word:       ECCWord{Data: data, ECC: ecc, Scheme: SchemeHammingSECDED64},
+	}
 }
 
 // eccMasks contains precomputed masks for Hamming parity calculation.
-// Uses standard Hamming encoding where parity bits occupy power-of-2 positions
-// (1, 2, 4, 8, 16, 32, 64) and data bits occupy the remaining positions.
-// This ensures power-of-2 syndromes always indicate ECC errors, not data errors.
+// Uses standard Hamming encoding where parity bits occupy power-of-2
+// positions (1, 2, 4, 8, 16, 32, 64) and data bits occupy the remaining
+// positions. This ensures power-of-2 syndromes always indicate ECC errors,
+// not data errors.
 var eccMasks = [7]uint64{
 	0xAB55555556AAAD5B, // P0
 	0xCD9999999B33366D, // P1
@@ -156,11 +290,11 @@ func isPowerOfTwo(n int) bool {
 	return n > 0 && n&(n-1) == 0
 }
 
-// computeECC calculates the 8 ECC bits for a given 64-bit data word. This
+// computeECC64 calculates the 8 ECC bits for a given 64-bit data word. This
 // implements the SECDED-style scheme of:
 //   - 7 Hamming parity bits: locate a single flipped bit
 //   - 1 overall parity bit: detect double-bit errors
-func computeECC(data uint64) uint8 {
+func computeECC64(data uint64) uint8 {
 	var ecc uint8
 
 	for i, mask := range eccMasks {
@@ -177,6 +311,764 @@ func computeECC(data uint64) uint8 {
 	return ecc
 }
 
+// --- HammingSECDED32 / HammingSECDED16: narrower code strengths ------------
+
+// hammingLayout precomputes, for a given data width, which Hamming position
+// each data bit occupies among the interleaved data+parity positions. It is
+// the generalization of the hand-tuned eccMasks/hammingToData tables above
+// to arbitrary widths.
+type hammingLayout struct {
+	dataBits   int
+	parityBits int
+	posToData  []int // Hamming position (1-indexed) -> data bit index, or -1
+	dataToPos  []int // data bit index -> Hamming position (1-indexed)
+}
+
+func newHammingLayout(dataBits int) *hammingLayout {
+	parityBits := 0
+	for (1 << parityBits) < dataBits+parityBits+1 {
+		parityBits++
+	}
+
+	total := dataBits + parityBits
+	l := &hammingLayout{
+		dataBits:   dataBits,
+		parityBits: parityBits,
+		posToData:  make([]int, total+1),
+		dataToPos:  make([]int, dataBits),
+	}
+	for i := range l.posToData {
+		l.posToData[i] = -1
+	}
+
+	d := 0
+	for pos := 1; pos <= total; pos++ {
+		if isPowerOfTwo(pos) {
+			continue
+		}
+		l.posToData[pos] = d
+		l.dataToPos[d] = pos
+		d++
+	}
+	return l
+}
+
+func (l *hammingLayout) dataMask() uint64 {
+	return (uint64(1) << l.dataBits) - 1
+}
+
+// parityMask returns the mask of data bits that feed into Hamming parity
+// bit `bit` (0-indexed, so bit 0 is the position-1 parity bit).
+func (l *hammingLayout) parityMask(bit int) uint64 {
+	var mask uint64
+	for d := 0; d < l.dataBits; d++ {
+		if l.dataToPos[d]&(1<<bit) != 0 {
+			mask |= 1 << d
+		}
+	}
+	return mask
+}
+
+// genericHamming implements extended-Hamming SECDED for an arbitrary data
+// width, built from a hammingLayout computed once at construction time.
+// hammingSECDED64 keeps its own hand-tuned masks above for backwards
+// compatibility; the narrower strengths use this instead of hand-deriving
+// new tables.
+type genericHamming struct {
+	id          SchemeID
+	layout      *hammingLayout
+	parityMasks []uint64
+}
+
+func newGenericHamming(id SchemeID, dataBits int) *genericHamming {
+	layout := newHammingLayout(dataBits)
+	masks := make([]uint64, layout.parityBits)
+	for i := range masks {
+		masks[i] = layout.parityMask(i)
+	}
+	return &genericHamming{id: id, layout: layout, parityMasks: masks}
+}
+
+func (g *genericHamming) encode(data uint64) uint16 {
+	data &= g.layout.dataMask()
+
+	var ecc uint16
+	for i, mask := range g.parityMasks {
+		if bits.OnesCount64(data&mask)%2 != 0 {
+			ecc |= 1 << i
+		}
+	}
+
+	if (bits.OnesCount64(data)+bits.OnesCount16(ecc))%2 != 0 {
+		ecc |= 1 << len(g.parityMasks)
+	}
+
+	return ecc
+}
+
+func (g *genericHamming) decode(data uint64, ecc uint16) (uint64, uint16, ReadStatus, CorrectionEvent, error) {
+	data &= g.layout.dataMask()
+
+	expected := g.encode(data)
+	syndrome := ecc ^ expected
+
+	hammingMask := uint16(1<<uint(g.layout.parityBits)) - 1
+	hammingSyndrome := syndrome & hammingMask
+
+	totalOnes := bits.OnesCount64(data) + bits.OnesCount16(ecc)
+	parityError := totalOnes%2 != 0
+
+	if hammingSyndrome == 0 && !parityError {
+		return data, ecc, StatusOK, CorrectionEvent{}, nil
+	}
+
+	if parityError && hammingSyndrome != 0 {
+		pos := int(hammingSyndrome)
+		if pos < len(g.layout.posToData) {
+			if dataBit := g.layout.posToData[pos]; dataBit >= 0 {
+				data ^= 1 << dataBit
+				event := CorrectionEvent{BitPositions: []int{dataBit}}
+				return data, g.encode(data), StatusCorrectedData, event, nil
+			}
+		}
+		// Position maps to a parity bit, not a data bit.
+		eccBit := bits.TrailingZeros(uint(hammingSyndrome))
+		event := CorrectionEvent{BitPositions: []int{g.layout.dataBits + eccBit}}
+		return data, g.encode(data), StatusCorrectedECC, event, nil
+	}
+
+	if parityError && hammingSyndrome == 0 {
+		event := CorrectionEvent{BitPositions: []int{g.layout.dataBits + g.layout.parityBits}}
+		return data, g.encode(data), StatusCorrectedECC, event, nil
+	}
+
+	return 0, 0, 0, CorrectionEvent{}, &UncorrectableError{
+		Syndrome:   uint8(hammingSyndrome),
+		ParityEven: !parityError,
+		Word:       ECCWord{Data: data, ECC: ecc, Scheme: g.id},
+	}
+}
+
+var genericHamming32 = newGenericHamming(SchemeHammingSECDED32, 32)
+var genericHamming16 = newGenericHamming(SchemeHammingSECDED16, 16)
+
+// hammingSECDED32 is a (39,32) SECDED variant: cheaper to store than
+// SECDED-64 when only 32 bits of data need protecting.
+type hammingSECDED32 struct{}
+
+func (hammingSECDED32) ID() SchemeID  { return SchemeHammingSECDED32 }
+func (hammingSECDED32) Name() string  { return "SECDED-32" }
+func (hammingSECDED32) DataBits() int { return 32 }
+func (hammingSECDED32) Encode(data uint64) uint16 {
+	return genericHamming32.encode(data)
+}
+func (hammingSECDED32) Decode(data uint64, ecc uint16) (uint64, uint16, ReadStatus, CorrectionEvent, error) {
+	return genericHamming32.decode(data, ecc)
+}
+
+// hammingSECDED16 is a (22,16) SECDED variant for the smallest protected
+// words, e.g. short counters or status registers.
+type hammingSECDED16 struct{}
+
+func (hammingSECDED16) ID() SchemeID  { return SchemeHammingSECDED16 }
+func (hammingSECDED16) Name() string  { return "SECDED-16" }
+func (hammingSECDED16) DataBits() int { return 16 }
+func (hammingSECDED16) Encode(data uint64) uint16 {
+	return genericHamming16.encode(data)
+}
+func (hammingSECDED16) Decode(data uint64, ecc uint16) (uint64, uint16, ReadStatus, CorrectionEvent, error) {
+	return genericHamming16.decode(data, ecc)
+}
+
+// --- SECDEDDAEC64: SEC-DED with adjacent-double-error correction -----------
+
+// daecLayout assigns each data bit a unique, nonzero syndrome column such
+// that every pair of physically adjacent data bits XORs to a syndrome that
+// is unique across all adjacent pairs and distinct from every single-bit
+// column. That's what lets Decode tell "one bit flipped" apart from "two
+// adjacent bits flipped" using the syndrome alone: on a miss, if the
+// syndrome matches a known adjacent-pair value and overall parity is even,
+// both bits get flipped instead of reporting an uncorrectable error.
+//
+// This needs more headroom than a plain SECDED code: codeBits=7 is exactly
+// enough to give every data bit its own column, but has nothing left over
+// for distinct pair syndromes, so DAEC variants use one extra Hamming
+// parity bit (8 here, vs. 7 for plain SECDED-64).
+type daecLayout struct {
+	dataBits   int
+	codeBits   int
+	columns    []uint16          // columns[i] = syndrome for flipping data bit i alone
+	pairToBits map[uint16][2]int // adjacent-pair syndrome -> (i, i+1)
+}
+
+func newDAECLayout(dataBits, codeBits int) *daecLayout {
+	limit := uint16(1) << uint(codeBits)
+
+	// Reserve the power-of-two values for "a lone ECC bit flipped in
+	// storage" (bit j alone flips the syndrome by exactly 1<<j). Data
+	// columns must avoid these so Decode can tell the two cases apart.
+	single := make(map[uint16]bool, dataBits+codeBits)
+	for j := 0; j < codeBits; j++ {
+		single[1<<uint(j)] = true
+	}
+	pair := make(map[uint16]bool, dataBits-1)
+
+	l := &daecLayout{
+		dataBits:   dataBits,
+		codeBits:   codeBits,
+		columns:    make([]uint16, dataBits),
+		pairToBits: make(map[uint16][2]int, dataBits-1),
+	}
+
+	for i := 0; i < dataBits; i++ {
+		assigned := false
+		for v := uint16(1); v < limit; v++ {
+			if single[v] {
+				continue
+			}
+
+			var pairSyn uint16
+			if i > 0 {
+				pairSyn = l.columns[i-1] ^ v
+				if pairSyn == 0 || single[pairSyn] || pair[pairSyn] {
+					continue
+				}
+			}
+
+			l.columns[i] = v
+			single[v] = true
+			if i > 0 {
+				pair[pairSyn] = true
+				l.pairToBits[pairSyn] = [2]int{i - 1, i}
+			}
+			assigned = true
+			break
+		}
+		assert(assigned, "DAEC layout ran out of distinct syndromes")
+	}
+
+	return l
+}
+
+func (l *daecLayout) columnOf(dataBit int) uint16 {
+	return l.columns[dataBit]
+}
+
+func (l *daecLayout) dataBitForColumn(syndrome uint16) (int, bool) {
+	for i, col := range l.columns {
+		if col == syndrome {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+var daecLayout64 = newDAECLayout(64, 8)
+
+// secdedDAEC64 implements a (73,64)-class SEC-DED-DAEC code: 64 bits of
+// data, 8 Hamming-style parity bits, and 1 overall parity bit, able to
+// correct any single-bit error as well as any burst that flips exactly two
+// physically adjacent data bits.
+type secdedDAEC64 struct{}
+
+func (secdedDAEC64) ID() SchemeID  { return SchemeSECDEDDAEC64 }
+func (secdedDAEC64) Name() string  { return "SECDED-DAEC-64" }
+func (secdedDAEC64) DataBits() int { return 64 }
+
+func (secdedDAEC64) Encode(data uint64) uint16 {
+	var syndrome uint16
+	for i := 0; i < 64; i++ {
+		if data&(1<<i) != 0 {
+			syndrome ^= daecLayout64.columnOf(i)
+		}
+	}
+
+	ecc := syndrome
+	if (bits.OnesCount64(data)+bits.OnesCount16(ecc))%2 != 0 {
+		ecc |= 1 << 8
+	}
+	return ecc
+}
+
+func (s secdedDAEC64) Decode(data uint64, ecc uint16) (uint64, uint16, ReadStatus, CorrectionEvent, error) {
+	expected := s.Encode(data)
+	syndrome := ecc ^ expected
+
+	hammingSyndrome := syndrome & 0xFF
+	totalOnes := bits.OnesCount64(data) + bits.OnesCount16(ecc)
+	parityError := totalOnes%2 != 0
+
+	if hammingSyndrome == 0 && !parityError {
+		return data, ecc, StatusOK, CorrectionEvent{}, nil
+	}
+
+	if parityError && hammingSyndrome != 0 {
+		if dataBit, ok := daecLayout64.dataBitForColumn(hammingSyndrome); ok {
+			data ^= 1 << dataBit
+			event := CorrectionEvent{BitPositions: []int{dataBit}}
+			return data, s.Encode(data), StatusCorrectedData, event, nil
+		}
+		// Not a single data column: a lone ECC bit flipped.
+		eccBit := bits.TrailingZeros(uint(hammingSyndrome))
+		event := CorrectionEvent{BitPositions: []int{64 + eccBit}}
+		return data, s.Encode(data), StatusCorrectedECC, event, nil
+	}
+
+	if parityError && hammingSyndrome == 0 {
+		event := CorrectionEvent{BitPositions: []int{64 + daecLayout64.codeBits}} // overall parity bit
+		return data, s.Encode(data), StatusCorrectedECC, event, nil
+	}
+
+	// Even parity with a non-zero syndrome means two bits flipped. If the
+	// syndrome matches a known adjacent-pair column, this is a correctable
+	// burst; otherwise it's a plain double-bit error we can only detect.
+	if bitPair, ok := daecLayout64.pairToBits[hammingSyndrome]; ok {
+		data ^= 1 << bitPair[0]
+		data ^= 1 << bitPair[1]
+		event := CorrectionEvent{BitPositions: []int{bitPair[0], bitPair[1]}}
+		return data, s.Encode(data), StatusCorrectedAdjacentDouble, event, nil
+	}
+
+	return 0, 0, 0, CorrectionEvent{}, &UncorrectableError{
+		Syndrome:   uint8(hammingSyndrome),
+		ParityEven: !parityError,
+		Word:       ECCWord{Data: data, ECC: ecc, Scheme: SchemeSECDEDDAEC64},
+	}
+}
+
+// --- ECCBlock: bit-interleaved multi-word blocks for burst tolerance -------
+
+// ECCBlockWords is the number of words stored in an ECCBlock.
+const ECCBlockWords = 8
+
+// eccBlockWordBits is the physical width of each word once encoded with
+// blockScheme: 64 data bits + 8 ECC bits.
+const eccBlockWordBits = 64 + 8
+
+// blockScheme is the per-word code ECCBlock encodes with. Interleaving only
+// needs to turn a burst into one flipped bit per word; a single-error-
+// correcting code is all that's required to clean it up afterwards.
+var blockScheme = hammingSECDED64{}
+
+// ECCBlock stores ECCBlockWords words with their SECDED-64 encoding
+// bit-interleaved across the block: bit i of every word in the block sits
+// together in physical storage before bit i+1 of any word. A physical
+// burst that corrupts up to ECCBlockWords adjacent physical bits then
+// lands on at most one bit per logical word, so the per-word SECDED code
+// can still recover every word even though it can only correct a single
+// bit each. This is the same trick DRAM controllers and pager/POCSAG-style
+// receivers use to turn burst errors into isolated single-bit errors.
+type ECCBlock struct {
+	// planes[i] packs bit i of every encoded word: bit j of planes[i] is
+	// bit i of word j's (data||ecc) representation.
+	planes [eccBlockWordBits]uint8
+}
+
+// WriteBlock encodes words with the block's per-word scheme and
+// bit-interleaves the results into a new ECCBlock.
+func WriteBlock(words [ECCBlockWords]uint64) *ECCBlock {
+	var ecc [ECCBlockWords]uint8
+	for j, data := range words {
+		ecc[j] = uint8(blockScheme.Encode(data))
+	}
+	return interleave(words, ecc)
+}
+
+// ReadBlock decodes every word in the block, correcting any single-bit
+// errors in place, and returns a ReadResult per word. If one or more words
+// are uncorrectable it still decodes the rest and returns a non-nil error
+// describing which word indices failed.
+func ReadBlock(b *ECCBlock) ([ECCBlockWords]ReadResult, error) {
+	assert(b != nil, "require ECCBlock to read")
+
+	data, ecc := deinterleave(b)
+
+	var results [ECCBlockWords]ReadResult
+	var failed []int
+	for j := range data {
+		d, e, status, event, err := blockScheme.Decode(data[j], uint16(ecc[j]))
+		if err != nil {
+			failed = append(failed, j)
+			continue
+		}
+		data[j], ecc[j] = d, uint8(e)
+		results[j] = ReadResult{Data: d, Status: status, Event: event}
+	}
+
+	*b = *interleave(data, ecc)
+
+	if len(failed) > 0 {
+		return results, &ErrUncorrectableBlock{Words: failed}
+	}
+	return results, nil
+}
+
+// Scrub reads every word in the block, correcting any single-bit errors,
+// and writes the corrected words back into the block's interleaved
+// storage. It's meant to be called periodically (see Scrubber) so latent
+// single-bit errors get fixed before a second bit flip in the same word
+// makes them unrecoverable.
+func Scrub(b *ECCBlock) error {
+	_, err := ReadBlock(b)
+	return err
+}
+
+// ErrUncorrectableBlock reports which words in an ECCBlock read could not
+// be corrected.
+type ErrUncorrectableBlock struct {
+	Words []int
+}
+
+func (e *ErrUncorrectableBlock) Error() string {
+	return fmt.Sprintf("ecc: uncorrectable error in block words %v", e.Words)
+}
+
+func (e *ErrUncorrectableBlock) Unwrap() error { return ErrUncorrectable }
+
+// interleave encodes data+ecc for every word into a freshly bit-interleaved
+// ECCBlock.
+func interleave(data [ECCBlockWords]uint64, ecc [ECCBlockWords]uint8) *ECCBlock {
+	b := &ECCBlock{}
+	for j := 0; j < ECCBlockWords; j++ {
+		for i := 0; i < 64; i++ {
+			if data[j]&(1<<i) != 0 {
+				b.planes[i] |= 1 << j
+			}
+		}
+		for i := 0; i < 8; i++ {
+			if ecc[j]&(1<<i) != 0 {
+				b.planes[64+i] |= 1 << j
+			}
+		}
+	}
+	return b
+}
+
+// deinterleave recovers each word's data and ECC from the block's
+// bit-interleaved physical storage.
+func deinterleave(b *ECCBlock) (data [ECCBlockWords]uint64, ecc [ECCBlockWords]uint8) {
+	for i := 0; i < 64; i++ {
+		for j := 0; j < ECCBlockWords; j++ {
+			if b.planes[i]&(1<<j) != 0 {
+				data[j] |= 1 << i
+			}
+		}
+	}
+	for i := 0; i < 8; i++ {
+		for j := 0; j < ECCBlockWords; j++ {
+			if b.planes[64+i]&(1<<j) != 0 {
+				ecc[j] |= 1 << i
+			}
+		}
+	}
+	return data, ecc
+}
+
+// --- Scrubber: background memory scrubbing ---------------------------------
+
+// ScrubStats holds counters describing a Scrubber's activity since it
+// started.
+type ScrubStats struct {
+	WordsScrubbed       uint64
+	DataCorrections     uint64
+	ECCCorrections      uint64
+	UncorrectableEvents uint64
+}
+
+// Scrubber periodically walks a slice of ECCWord in the background,
+// reading (and so correcting) each one in turn so latent single-bit
+// errors get fixed before a second bit flip in the same word makes it
+// uncorrectable. This mirrors the scrubbing pattern used by real ECC
+// memory controllers.
+type Scrubber struct {
+	mem  []*ECCWord
+	rate time.Duration
+
+	// OnUncorrectable, if set before Start, is invoked whenever a scrub
+	// pass hits a word Read can't correct, e.g. to log or page on it.
+	OnUncorrectable func(index int, word *ECCWord)
+
+	mu    sync.Mutex
+	stats ScrubStats
+
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewScrubber creates a Scrubber over mem that, once started, reads one
+// word every rate, cycling back to the start of mem when it reaches the
+// end.
+func NewScrubber(mem []*ECCWord, rate time.Duration) *Scrubber {
+	return &Scrubber{mem: mem, rate: rate}
+}
+
+// Start begins scrubbing in the background. It returns immediately; the
+// scrub loop stops when ctx is cancelled or Stop is called. Start is a
+// no-op if the scrubber is already running -- calling it again without an
+// intervening Stop would otherwise overwrite s.cancel/s.done and leak the
+// first loop's goroutine, since nothing could reach it to stop it anymore.
+func (s *Scrubber) Start(ctx context.Context) {
+	if s.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	s.cancel = cancel
+	s.done = make(chan struct{})
+
+	go func() {
+		defer close(s.done)
+
+		if len(s.mem) == 0 {
+			return
+		}
+
+		ticker := time.NewTicker(s.rate)
+		defer ticker.Stop()
+
+		index := 0
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				s.scrubOne(index)
+				index = (index + 1) % len(s.mem)
+			}
+		}
+	}()
+}
+
+// Stop halts the background scrub loop and waits for it to exit. After Stop
+// returns, Start may be called again to begin a fresh scrub loop.
+func (s *Scrubber) Stop() {
+	if s.cancel == nil {
+		return
+	}
+	s.cancel()
+	<-s.done
+	s.cancel = nil
+}
+
+// Stats returns a snapshot of the scrubber's correction counters.
+func (s *Scrubber) Stats() ScrubStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.stats
+}
+
+func (s *Scrubber) scrubOne(index int) {
+	word := s.mem[index]
+	result, err := Read(word)
+
+	s.mu.Lock()
+	s.stats.WordsScrubbed++
+	if err == nil {
+		switch result.Status {
+		case StatusCorrectedData:
+			s.stats.DataCorrections++
+		case StatusCorrectedECC:
+			s.stats.ECCCorrections++
+		}
+	} else {
+		s.stats.UncorrectableEvents++
+	}
+	s.mu.Unlock()
+
+	if err != nil && s.OnUncorrectable != nil {
+		s.OnUncorrectable(index, word)
+	}
+}
+
+// --- ECCWriter / ECCReader: streaming ECC-encoded byte streams -------------
+
+// frameSize is the encoded size of one 8-byte data word: 8 data bytes
+// followed by 1 ECC byte.
+const frameSize = 9
+
+// ECCStreamStats counts the single-bit corrections an ECCReader has made.
+type ECCStreamStats struct {
+	DataCorrections uint64
+	ECCCorrections  uint64
+}
+
+// ECCWriter wraps an io.Writer, chunking written bytes into 8-byte data
+// words, ECC-encoding each with SECDED-64, and emitting 9-byte frames (8
+// data bytes + 1 ECC byte). Callers must call Close to flush the final,
+// PKCS7-style padded frame -- padding is always added, even when the
+// input is an exact multiple of 8 bytes, so ECCReader can trim it back to
+// the real length without needing to know it in advance.
+type ECCWriter struct {
+	w      io.Writer
+	scheme ECCScheme
+	buf    [8]byte
+	buflen int
+}
+
+// NewECCWriter wraps w, ECC-encoding every 8 bytes written into a 9-byte
+// frame.
+func NewECCWriter(w io.Writer) *ECCWriter {
+	return &ECCWriter{w: w, scheme: hammingSECDED64{}}
+}
+
+// Write implements io.Writer. Writes may be split across calls; bytes are
+// only flushed downstream once a full 8-byte word has accumulated.
+func (ew *ECCWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		copied := copy(ew.buf[ew.buflen:], p)
+		ew.buflen += copied
+		p = p[copied:]
+		n += copied
+
+		if ew.buflen == 8 {
+			if err := ew.flush(0); err != nil {
+				return n, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Close flushes the final frame, padding any trailing partial word
+// PKCS7-style, and returns any write error. It does not close the
+// underlying writer.
+func (ew *ECCWriter) Close() error {
+	return ew.flush(byte(8 - ew.buflen))
+}
+
+// flush pads the buffered bytes up to 8 with padValue, encodes them as one
+// frame, writes it downstream, and resets the buffer.
+func (ew *ECCWriter) flush(padValue byte) error {
+	for i := ew.buflen; i < 8; i++ {
+		ew.buf[i] = padValue
+	}
+
+	word := Write(binary.BigEndian.Uint64(ew.buf[:]), ew.scheme)
+
+	var frame [frameSize]byte
+	binary.BigEndian.PutUint64(frame[:8], word.Data)
+	frame[8] = byte(word.ECC)
+
+	ew.buflen = 0
+	_, err := ew.w.Write(frame[:])
+	return err
+}
+
+// ECCReader wraps an io.Reader, reading 9-byte ECC frames, decoding them
+// (correcting any single-bit error) with SECDED-64, and exposing the
+// original byte stream with the final frame's PKCS7 padding stripped.
+type ECCReader struct {
+	r      io.Reader
+	scheme ECCScheme
+
+	pending []byte // lookahead frame, not yet known to be the final one
+	have    bool
+	out     []byte // decoded bytes ready to hand back to the caller
+	err     error  // sticky error, including io.EOF, once the stream ends
+	stats   ECCStreamStats
+}
+
+// NewECCReader wraps r, decoding 9-byte ECC frames back into a plain byte
+// stream.
+func NewECCReader(r io.Reader) *ECCReader {
+	return &ECCReader{r: r, scheme: hammingSECDED64{}}
+}
+
+// Read implements io.Reader. It returns ErrUncorrectable if a frame has a
+// multi-bit error the scheme can't correct.
+func (er *ECCReader) Read(p []byte) (int, error) {
+	for len(er.out) == 0 && er.err == nil {
+		if err := er.advance(); err != nil {
+			er.err = err
+		}
+	}
+	if len(er.out) == 0 {
+		return 0, er.err
+	}
+
+	n := copy(p, er.out)
+	er.out = er.out[n:]
+	return n, nil
+}
+
+// Stats returns the number of single-bit corrections made so far.
+func (er *ECCReader) Stats() ECCStreamStats {
+	return er.stats
+}
+
+// advance decodes one more frame's worth of bytes into er.out, using a
+// one-frame lookahead so the true final frame can be told apart from an
+// ordinary one and have its padding stripped.
+func (er *ECCReader) advance() error {
+	if !er.have {
+		data, ok, err := er.readFrame()
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return io.EOF
+		}
+		er.pending, er.have = data, true
+	}
+
+	next, ok, err := er.readFrame()
+	if err != nil {
+		// The lookahead frame failed to decode, but er.pending already holds
+		// a good, corrected frame the caller hasn't seen yet. Flush it to
+		// er.out first so Read surfaces it before the terminal error,
+		// instead of silently dropping the last valid frame of the stream.
+		er.out = er.pending
+		er.have = false
+		return err
+	}
+	if !ok {
+		pad := int(er.pending[7])
+		if pad < 1 || pad > 8 {
+			return fmt.Errorf("ecc: invalid stream padding %d", pad)
+		}
+		er.out = er.pending[:8-pad]
+		er.have = false
+		return io.EOF
+	}
+
+	er.out, er.pending = er.pending, next
+	return nil
+}
+
+// readFrame reads one 9-byte frame and decodes it. ok is false (with a nil
+// error) on a clean EOF between frames.
+func (er *ECCReader) readFrame() (data []byte, ok bool, err error) {
+	var frame [frameSize]byte
+	if _, err := io.ReadFull(er.r, frame[:]); err != nil {
+		if err == io.EOF {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	word := &ECCWord{
+		Data:   binary.BigEndian.Uint64(frame[:8]),
+		ECC:    uint16(frame[8]),
+		Scheme: er.scheme.ID(),
+	}
+	result, err := Read(word)
+	if err != nil {
+		return nil, false, err
+	}
+
+	switch result.Status {
+	case StatusCorrectedData:
+		er.stats.DataCorrections++
+	case StatusCorrectedECC:
+		er.stats.ECCCorrections++
+	}
+
+	out := make([]byte, 8)
+	binary.BigEndian.PutUint64(out, result.Data)
+	return out, true, nil
+}
+
 func assert(condition bool, msg string) {
 	if !condition {
 		panic("assertion failed: " + msg)
@@ -185,37 +1077,46 @@ func assert(condition bool, msg string) {
 
 type testCase struct {
 	name    string
+	scheme  ECCScheme
 	data    uint64
 	dataXOR uint64 // bits to flip in data
-	eccXOR  uint8  // bits to flip in ECC
+	eccXOR  uint16 // bits to flip in ECC
 }
 
 func main() {
 	tests := []testCase{
 		// No error
-		{"Clean read", 0xDEADBEEFCAFEBABE, 0, 0},
+		{"Clean read", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0},
 
 		// Single-bit data errors
-		{"Single-bit data error (bit 0)", 0xDEADBEEFCAFEBABE, 0x01, 0},
-		{"Single-bit data error (bit 2)", 0xDEADBEEFCAFEBABE, 0x04, 0},
-		{"Single-bit data error (bit 63)", 0xDEADBEEFCAFEBABE, 1 << 63, 0},
+		{"Single-bit data error (bit 0)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0x01, 0},
+		{"Single-bit data error (bit 2)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0x04, 0},
+		{"Single-bit data error (bit 63)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 1 << 63, 0},
 
 		// Single-bit ECC errors (Hamming parity bits P0-P6)
-		{"Single-bit ECC error (P0)", 0xDEADBEEFCAFEBABE, 0, 0x01},
-		{"Single-bit ECC error (P1)", 0xDEADBEEFCAFEBABE, 0, 0x02},
-		{"Single-bit ECC error (P2)", 0xDEADBEEFCAFEBABE, 0, 0x04},
-		{"Single-bit ECC error (P6)", 0xDEADBEEFCAFEBABE, 0, 0x40},
-		{"Single-bit ECC error (overall parity)", 0xDEADBEEFCAFEBABE, 0, 0x80},
+		{"Single-bit ECC error (P0)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x01},
+		{"Single-bit ECC error (P1)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x02},
+		{"Single-bit ECC error (P2)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x04},
+		{"Single-bit ECC error (P6)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x40},
+		{"Single-bit ECC error (overall parity)", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x80},
+
+		// Multi-bit errors (uncorrectable under plain SECDED)
+		{"Multi-bit data error", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0x05, 0},
+		{"Multi-bit ECC error", hammingSECDED64{}, 0xDEADBEEFCAFEBABE, 0, 0x03},
 
-		// Multi-bit errors (uncorrectable)
-		{"Multi-bit data error", 0xDEADBEEFCAFEBABE, 0x05, 0},
-		{"Multi-bit ECC error", 0xDEADBEEFCAFEBABE, 0, 0x03},
+		// Narrower code strengths
+		{"SECDED-32 single-bit data error", hammingSECDED32{}, 0xCAFEBABE, 0x10, 0},
+		{"SECDED-16 single-bit data error", hammingSECDED16{}, 0xBEEF, 0x02, 0},
+
+		// DAEC: adjacent double-bit burst is now correctable
+		{"DAEC adjacent-burst data error (bits 4,5)", secdedDAEC64{}, 0xDEADBEEFCAFEBABE, 0x30, 0},
+		{"DAEC single-bit data error (bit 9)", secdedDAEC64{}, 0xDEADBEEFCAFEBABE, 1 << 9, 0},
 	}
 
 	for _, tc := range tests {
 		fmt.Printf("[%s]\n", tc.name)
 
-		word := Write(tc.data)
+		word := Write(tc.data, tc.scheme)
 		fmt.Printf("\tOriginal: %s\n", word)
 
 		word.Data ^= tc.dataXOR
@@ -229,6 +1130,9 @@ func main() {
 		} else {
 			fmt.Printf("\n\tRecovered: %s\n", word)
 			fmt.Printf("\tStatus: %s\n", result.Status)
+			if len(result.Event.BitPositions) > 0 {
+				fmt.Printf("\tCorrected bit(s): %v\n", result.Event.BitPositions)
+			}
 		}
 		fmt.Println()
 	}
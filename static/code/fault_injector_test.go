@@ -0,0 +1,78 @@
+package main
+
+import "math/rand"
+
+// FaultPlacement controls how a FaultInjector distributes flipped bits
+// across a word's 72-bit physical representation: bits 0-63 are Data, bits
+// 64-71 are ECC.
+type FaultPlacement int
+
+const (
+	PlacementUniform       FaultPlacement = iota // anywhere across all 72 bits
+	PlacementAdjacentBurst                       // a contiguous run of bits, as from a physical burst
+	PlacementECCOnly                             // only within the 8 ECC bits
+	PlacementDataOnly                            // only within the 64 data bits
+)
+
+// FaultInjector deterministically corrupts ECCWords for testing, using a
+// caller-supplied rand.Source so a given seed always reproduces the same
+// sequence of flips.
+type FaultInjector struct {
+	rng *rand.Rand
+}
+
+// NewFaultInjector creates a FaultInjector seeded from src.
+func NewFaultInjector(src rand.Source) *FaultInjector {
+	return &FaultInjector{rng: rand.New(src)}
+}
+
+// Flip corrupts w in place, flipping exactly k bits of its 72-bit stored
+// representation according to placement, and returns the bit positions
+// that were flipped.
+func (f *FaultInjector) Flip(w *ECCWord, k int, placement FaultPlacement) []int {
+	var positions []int
+
+	switch placement {
+	case PlacementUniform:
+		positions = f.choose(k, 0, 72)
+	case PlacementAdjacentBurst:
+		assert(k <= 72, "fault injector: burst length exceeds word width")
+		start := f.rng.Intn(72 - k + 1)
+		for i := 0; i < k; i++ {
+			positions = append(positions, start+i)
+		}
+	case PlacementECCOnly:
+		positions = f.choose(k, 64, 72)
+	case PlacementDataOnly:
+		positions = f.choose(k, 0, 64)
+	default:
+		panic("fault injector: unknown placement")
+	}
+
+	for _, pos := range positions {
+		flipBit(w, pos)
+	}
+	return positions
+}
+
+// choose picks k distinct positions from [lo, hi) without replacement.
+func (f *FaultInjector) choose(k, lo, hi int) []int {
+	assert(k <= hi-lo, "fault injector: k exceeds available bit range")
+
+	pool := make([]int, hi-lo)
+	for i := range pool {
+		pool[i] = lo + i
+	}
+	f.rng.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+
+	return append([]int(nil), pool[:k]...)
+}
+
+// flipBit flips bit position pos (0-63 Data, 64-71 ECC) of w in place.
+func flipBit(w *ECCWord, pos int) {
+	if pos < 64 {
+		w.Data ^= 1 << uint(pos)
+	} else {
+		w.ECC ^= 1 << uint(pos-64)
+	}
+}
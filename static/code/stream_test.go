@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+// TestECCReaderFlushesPendingFrameBeforeError reproduces a bug where
+// advance's one-frame lookahead discarded the last successfully-decoded,
+// non-final frame when a later frame failed to decode: io.ReadAll would go
+// straight from 0 bytes to the terminal error, silently dropping valid data
+// the caller never got a chance to see.
+func TestECCReaderFlushesPendingFrameBeforeError(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewECCWriter(&buf)
+	if _, err := w.Write([]byte("GOODWORD")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if _, err := w.Write([]byte("BADWORD!")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	// Corrupt two bits of the second frame's data so it's detected but
+	// uncorrectable, without touching the first frame at all.
+	frame := buf.Bytes()[frameSize : 2*frameSize]
+	frame[0] ^= 0x03
+
+	r := NewECCReader(&buf)
+	got, err := io.ReadAll(r)
+
+	var uncorrectable *UncorrectableError
+	if !errors.As(err, &uncorrectable) {
+		t.Fatalf("ReadAll err = %v, want *UncorrectableError", err)
+	}
+	if string(got) != "GOODWORD" {
+		t.Fatalf("ReadAll data = %q, want %q (first frame must not be dropped)", got, "GOODWORD")
+	}
+}
+
+// TestECCWriterReaderRoundTrip checks clean data of various lengths --
+// empty, an exact multiple of 8 bytes, and a trailing partial word -- comes
+// back unchanged and with no corrections reported.
+func TestECCWriterReaderRoundTrip(t *testing.T) {
+	for _, want := range [][]byte{
+		[]byte(""),
+		[]byte("12345678"),
+		[]byte("hello, ECC world"),
+	} {
+		var buf bytes.Buffer
+		w := NewECCWriter(&buf)
+		if _, err := w.Write(want); err != nil {
+			t.Fatalf("Write(%q): %v", want, err)
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close(%q): %v", want, err)
+		}
+
+		r := NewECCReader(&buf)
+		got, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("ReadAll(%q): %v", want, err)
+		}
+		if !bytes.Equal(got, want) {
+			t.Fatalf("round trip = %q, want %q", got, want)
+		}
+		if stats := r.Stats(); stats.DataCorrections != 0 || stats.ECCCorrections != 0 {
+			t.Fatalf("round trip(%q): unexpected corrections %+v", want, stats)
+		}
+	}
+}
+
+// TestECCReaderCorrectsSingleBitFrameError confirms a single-bit error in a
+// frame is transparently corrected and counted in Stats.
+func TestECCReaderCorrectsSingleBitFrameError(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewECCWriter(&buf)
+	if _, err := w.Write([]byte("GOODWORD")); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+
+	frame := buf.Bytes()[:frameSize]
+	frame[0] ^= 0x01
+
+	r := NewECCReader(&buf)
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("ReadAll: %v", err)
+	}
+	if string(got) != "GOODWORD" {
+		t.Fatalf("ReadAll = %q, want %q", got, "GOODWORD")
+	}
+	if stats := r.Stats(); stats.DataCorrections != 1 {
+		t.Fatalf("Stats = %+v, want DataCorrections = 1", stats)
+	}
+}